@@ -23,7 +23,8 @@ export async function main() {
     // Create destination directory
     await fs.mkdir(destDir, { recursive: true });
     
-    // Copy with rsync, excluding node_modules and other unnecessary files
+    // Copy with rsync, excluding node_modules and other unnecessary files.
+    // --safe-links skips upstream symlinks that point outside the copied tree.
     const excludes = [
         '--exclude=node_modules',
         '--exclude=.git',
@@ -35,7 +36,7 @@ export async function main() {
     
     try {
         const { stdout, stderr } = await execAsync(
-            `rsync -av ${excludes} "${sourceDir}/" "${destDir}/"`
+            `rsync -av --safe-links ${excludes} "${sourceDir}/" "${destDir}/"`
         );
         
         if (stderr && !stderr.includes('rsync warning')) {
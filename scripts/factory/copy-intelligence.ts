@@ -34,9 +34,10 @@ export async function main() {
             // Create destination subdirectory
             await fs.mkdir(destDir, { recursive: true });
             
-            // Copy .md and .yml files
+            // Copy .md and .yml files. --safe-links skips upstream symlinks that point
+            // outside the copied tree, so they cannot escape the build directory.
             const { stdout, stderr } = await execAsync(
-                `rsync -av --include='*.md' --include='*.yml' --include='*.yaml' --include='*/' --exclude='*' "${sourceDir}/" "${destDir}/"`
+                `rsync -av --safe-links --include='*.md' --include='*.yml' --include='*.yaml' --include='*/' --exclude='*' "${sourceDir}/" "${destDir}/"`
             );
             
             console.log(`✓ Copied ${dir.source} → ${dir.dest}`);
@@ -45,18 +46,28 @@ export async function main() {
         }
     }
     
-    // Copy root configuration files
+    // Copy root configuration files. fs.copyFile follows symlinks, so each file is
+    // resolved first and must stay inside the submodule.
     const rootFiles = ['README.md', 'CLAUDE.md', 'WORKFLOW.md'];
+    const realSourceBase = await fs.realpath(sourceBase).catch(() => null);
     for (const file of rootFiles) {
         const sourcePath = path.join(sourceBase, file);
         const destPath = path.join(destBase, file);
         
+        let realSourcePath: string;
         try {
-            await fs.copyFile(sourcePath, destPath);
-            console.log(`✓ Copied ${file}`);
+            realSourcePath = await fs.realpath(sourcePath);
         } catch {
             // File might not exist, continue
+            continue;
         }
+        
+        if (!realSourceBase || !realSourcePath.startsWith(realSourceBase + path.sep)) {
+            throw new Error(`Refusing to copy ${file}: it resolves to ${realSourcePath}, outside of ${sourceBase}`);
+        }
+        
+        await fs.copyFile(realSourcePath, destPath);
+        console.log(`✓ Copied ${file}`);
     }
     
     // Create index file for easy access
@@ -3,6 +3,7 @@
 import { Project, SyntaxKind } from 'ts-morph';
 import * as path from 'path';
 import * as fs from 'fs/promises';
+import { categoryKeywords } from '../../src/session/detection-patterns';
 
 export async function main() {
     console.log('🔧 Patching orchestrator with SuperClaude logic...');
@@ -44,18 +45,14 @@ interface DetectedIntent {
     confidence: number;
 }
 
+// Generated from categoryKeywords in SuperCode's src/session/detection-patterns.ts
 function detectDomain(input: string): string {
-    const keywords = {
-        'analysis': ['analyze', 'explain', 'understand', 'review', 'audit'],
-        'modification': ['implement', 'improve', 'refactor', 'fix', 'update'],
-        'process': ['build', 'test', 'deploy', 'validate', 'compile'],
-        'utility': ['help', 'search', 'document', 'visualize', 'compare']
-    };
+    const keywords: Record<string, string[]> = ${JSON.stringify(categoryKeywords)};
     
-    const lowerInput = input.toLowerCase();
+    const words = input.toLowerCase().split(/[^a-z0-9]+/);
     
     for (const [domain, domainKeywords] of Object.entries(keywords)) {
-        if (domainKeywords.some(keyword => lowerInput.includes(keyword))) {
+        if (domainKeywords.some(keyword => words.includes(keyword))) {
             return domain;
         }
     }
@@ -12,12 +12,108 @@ const REPO_ROOT = path.resolve(SCRIPT_DIR, '../../'); // Resolve to the SuperCod
 const TEMPLATE_PATH = path.join(REPO_ROOT, 'scripts/pipeline/templates/command.ts.ejs');
 const SUBMODULE_COMMANDS_PATH = path.join(REPO_ROOT, 'external/superclaude/SuperClaude/Commands/*.md');
 const OUTPUT_DIR = path.join(REPO_ROOT, 'src/commands');
+const CMD_MODULE_PATH = path.join(REPO_ROOT, 'src/cmd');
 
 // Helper function to convert kebab-case or snake_case to camelCase for variable names
 function toCamelCase(str: string): string {
     return str.replace(/[-_](\w)/g, (_, c) => c.toUpperCase());
 }
 
+// Helper function to convert a command name into a valid PascalCase TypeScript identifier.
+// Any non-alphanumeric character acts as a word boundary, so 'dev-setup' becomes 'DevSetup'
+// and 'user:compress' becomes 'UserCompress'. When a set of taken identifiers is passed,
+// collisions are resolved by appending a numeric suffix and the result is recorded in the set.
+export function toIdentifier(str: string, taken?: Set<string>): string {
+    const words = str.split(/[^A-Za-z0-9]+/).filter(Boolean);
+    let identifier = words.map(w => w.charAt(0).toUpperCase() + w.slice(1)).join('') || 'Unnamed';
+    if (/^[0-9]/.test(identifier)) {
+        identifier = `_${identifier}`;
+    }
+
+    if (taken) {
+        let candidate = identifier;
+        for (let suffix = 2; taken.has(candidate); suffix++) {
+            candidate = `${identifier}${suffix}`;
+        }
+        taken.add(candidate);
+        identifier = candidate;
+    }
+    return identifier;
+}
+
+// Helper function to split a namespaced command name such as 'user:compress' into its
+// namespace segments and base name. Un-namespaced names yield an empty namespace list.
+// Segments that are empty, '.' or '..', or that contain a path separator are rejected,
+// since they would not map one-to-one onto a directory or file name.
+export function parseCommandName(str: string): { namespace: string[]; name: string } {
+    const segments = str.split(':');
+    for (const segment of segments) {
+        if (segment === '' || segment === '.' || segment === '..' || /[\\/]/.test(segment)) {
+            throw new Error(`Invalid command name '${str}': bad segment '${segment}'`);
+        }
+    }
+    const name = segments.pop()!;
+    return { namespace: segments, name };
+}
+
+// Returns the output path of a generated command relative to the commands directory.
+// Namespaces become nested directories, so 'user:compress' is written to 'user/compress.ts'
+// instead of a 'user:compress.ts' file name that some filesystems reject.
+export function commandOutputPath(commandName: string): string {
+    const { namespace, name } = parseCommandName(commandName);
+    return path.join(...namespace.map(toCamelCase), `${toCamelCase(name)}.ts`);
+}
+
+// Resolves a generated file path against the output directory and rejects any traversal
+// attempt, e.g. a frontmatter 'command' value such as '../../etc/passwd'. '..' segments are
+// rejected up front, even when the normalized path would land back inside the directory.
+export function resolveContainedPath(baseDir: string, relativePath: string): string {
+    if (relativePath.split(/[\\/]/).includes('..')) {
+        throw new Error(`Refusing to write '${relativePath}': path traversal is not allowed`);
+    }
+    const base = path.resolve(baseDir);
+    const resolved = path.resolve(base, relativePath);
+    if (!resolved.startsWith(base + path.sep)) {
+        throw new Error(`Refusing to write '${relativePath}' outside of ${base}`);
+    }
+    return resolved;
+}
+
+// Creates the parent directory of a contained output file. The nearest existing ancestor is
+// checked with fs.realpath first, so a symlinked subdirectory cannot redirect the write
+// outside the output directory. An existing symlink at the file path itself is refused too,
+// since fs.writeFile would follow it.
+export async function prepareContainedDirectory(baseDir: string, filePath: string): Promise<void> {
+    const realBase = await fs.realpath(baseDir);
+    let existingDir = path.dirname(filePath);
+    while (true) {
+        try {
+            await fs.access(existingDir);
+            break;
+        } catch {
+            existingDir = path.dirname(existingDir);
+        }
+    }
+
+    const realDir = await fs.realpath(existingDir);
+    if (realDir !== realBase && !realDir.startsWith(realBase + path.sep)) {
+        throw new Error(`Refusing to write '${filePath}': ${existingDir} resolves outside of ${realBase}`);
+    }
+
+    const existingFile = await fs.lstat(filePath).catch(() => null);
+    if (existingFile?.isSymbolicLink()) {
+        throw new Error(`Refusing to write '${filePath}': the output file is a symlink`);
+    }
+    await fs.mkdir(path.dirname(filePath), { recursive: true });
+}
+
+// Returns the import specifier for a module as seen from a generated file,
+// e.g. '../cmd' for 'src/commands/analyze.ts' and '../../cmd' for 'src/commands/user/compress.ts'.
+export function moduleImportPath(fromFile: string, modulePath: string): string {
+    const specifier = path.relative(path.dirname(fromFile), modulePath).split(path.sep).join('/');
+    return specifier.startsWith('.') ? specifier : `./${specifier}`;
+}
+
 export async function main() {
     console.log('Starting Command Generator...');
 
@@ -27,13 +123,16 @@ export async function main() {
         console.log('Successfully read template file.');
 
         // 2. Find all SuperClaude command markdown files
-        const commandFiles = await glob(SUBMODULE_COMMANDS_PATH);
+        // glob does not sort its results; sorting keeps collision suffixes stable across runs.
+        const commandFiles = (await glob(SUBMODULE_COMMANDS_PATH)).sort();
         if (commandFiles.length === 0) {
             throw new Error(`No command files found at: ${SUBMODULE_COMMANDS_PATH}`);
         }
         console.log(`Found ${commandFiles.length} command definition files.`);
 
         // 3. Process each command file
+        const takenIdentifiers = new Set<string>();
+        const writtenOutputPaths = new Map<string, string>();
         for (const filePath of commandFiles) {
             const fileName = path.basename(filePath, '.md');
             console.log(`Processing: ${fileName}.md`);
@@ -43,8 +142,27 @@ export async function main() {
             const { data } = matter(fileContent); // 'data' contains the frontmatter
 
             // Prepare data for the template
+            // The full name, namespace included, is what gets registered at runtime.
+            const commandName = toCamelCase(data.command || fileName);
+            const { namespace } = parseCommandName(commandName);
+            const relativeOutputPath = commandOutputPath(commandName);
+
+            // Names like 'dev_setup' and 'dev-setup' map to the same file; refuse to overwrite.
+            // Paths are compared case-insensitively to cover case-insensitive filesystems.
+            const outputKey = relativeOutputPath.toLowerCase();
+            const previousSource = writtenOutputPaths.get(outputKey);
+            if (previousSource) {
+                throw new Error(`Commands from ${previousSource} and ${path.basename(filePath)} both generate ${relativeOutputPath}`);
+            }
+            writtenOutputPaths.set(outputKey, path.basename(filePath));
+            const outputFilePath = resolveContainedPath(OUTPUT_DIR, relativeOutputPath);
+
             const templateData = {
-                commandName: toCamelCase(data.command || fileName),
+                commandName,
+                identifier: toIdentifier(commandName, takenIdentifiers),
+                namespace: namespace.join(':'),
+                sourceFile: path.basename(filePath),
+                cmdImportPath: moduleImportPath(outputFilePath, CMD_MODULE_PATH),
                 description: data.description || 'No description provided.',
                 aliases: data.aliases || [],
             };
@@ -53,9 +171,9 @@ export async function main() {
             const generatedCode = ejs.render(template, templateData);
 
             // 5. Write the generated TypeScript file
-            const outputFilePath = path.join(OUTPUT_DIR, `${templateData.commandName}.ts`);
+            await prepareContainedDirectory(OUTPUT_DIR, outputFilePath);
             await fs.writeFile(outputFilePath, generatedCode);
-            console.log(`Successfully generated: ${path.basename(outputFilePath)}`);
+            console.log(`Successfully generated: ${relativeOutputPath}`);
         }
 
         console.log('\nCommand Generator finished successfully!');
@@ -3,6 +3,7 @@
 
 import type { Argv } from "yargs";
 import { cmd } from "../cmd";
+import { getCommandCategory } from "../session/detection-patterns";
 
 // Help system implementation
 class HelpSystem {
@@ -19,7 +20,6 @@ class HelpSystem {
       analyze: {
         description: "Analyze code and architecture with deep insights",
         usage: "/analyze [target] [options]",
-        category: "analysis",
         frequency: 85,
         flags: [
           { name: "deep", description: "Enable deep analysis", type: "boolean" },
@@ -37,7 +37,6 @@ class HelpSystem {
       build: {
         description: "Build and compile projects with intelligent configuration",
         usage: "/build [target] [options]",
-        category: "process",
         frequency: 72,
         flags: [
           { name: "watch", description: "Watch for changes", type: "boolean" },
@@ -53,7 +52,6 @@ class HelpSystem {
       search: {
         description: "Search files and content with semantic understanding",
         usage: "/search <query> [options]",
-        category: "utility",
         frequency: 68,
         flags: [
           { name: "type", description: "Search type", type: "string", choices: ["files", "content", "both", "semantic"] },
@@ -69,7 +67,6 @@ class HelpSystem {
       document: {
         description: "Generate comprehensive documentation",
         usage: "/document [target] [options]",
-        category: "utility",
         frequency: 45,
         flags: [
           { name: "type", description: "Documentation type", type: "string", choices: ["api", "readme", "user", "dev"] },
@@ -85,7 +82,6 @@ class HelpSystem {
       spawn: {
         description: "Orchestrate complex tasks with sub-agents",
         usage: "/spawn <task> [options]",
-        category: "utility",
         frequency: 35,
         flags: [
           { name: "strategy", description: "Execution strategy", type: "string", choices: ["sequential", "parallel", "auto"] },
@@ -101,7 +97,6 @@ class HelpSystem {
       help: {
         description: "Get help and guidance for SuperClaude commands",
         usage: "/help [command] [options]",
-        category: "utility",
         frequency: 95,
         flags: [
           { name: "all", description: "Show all commands", type: "boolean" },
@@ -121,7 +116,7 @@ class HelpSystem {
     };
 
     Object.entries(commands).forEach(([name, config]) => {
-      this.commandRegistry.set(name, config);
+      this.commandRegistry.set(name, { ...config, category: getCommandCategory(name) });
     });
   }
 
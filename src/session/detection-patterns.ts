@@ -8,9 +8,48 @@ export const domainKeywords = {
     documentation: ["document", "readme", "wiki", "guide", "instructions", "commit"],
 };
 
+// Intent keywords drive persona selection only; command categories live in categoryKeywords.
 export const intentKeywords = {
     analysis: ["analyze", "review", "explain", "understand", "investigate", "troubleshoot"],
     creation: ["create", "build", "implement", "generate", "design"],
     modification: ["update", "refactor", "improve", "optimize", "fix"],
     debugging: ["debug", "fix", "troubleshoot", "resolve"],
 };
+
+// Single command category taxonomy shared by the help listing, the orchestrator and the
+// detectDomain function injected by scripts/factory/patch-orchestrator.ts.
+// Each category lists the command names and request keywords that belong to it; order matters,
+// as the first category with a matching keyword wins.
+export const categoryKeywords = {
+    analysis: ["analyze", "explain", "understand", "review", "audit", "troubleshoot", "estimate"],
+    modification: ["implement", "improve", "refactor", "fix", "update", "cleanup", "design"],
+    process: ["build", "test", "deploy", "validate", "compile", "git", "workflow", "task"],
+    utility: ["help", "search", "document", "visualize", "compare", "spawn", "load", "index"],
+};
+
+export type CommandCategory = keyof typeof categoryKeywords;
+
+// Category used for commands that are not listed in categoryKeywords.
+export const defaultCommandCategory: CommandCategory = "utility";
+
+// Returns the category of a command by name, or the default category for unknown commands.
+export function getCommandCategory(command: string): CommandCategory {
+    const name = command.toLowerCase();
+    for (const category in categoryKeywords) {
+        if (categoryKeywords[category as CommandCategory].includes(name)) {
+            return category as CommandCategory;
+        }
+    }
+    return defaultCommandCategory;
+}
+
+// Detects the command category from free-form input by whole-word keyword match.
+export function detectCategory(userInput: string): CommandCategory | null {
+    const words = userInput.toLowerCase().split(/[^a-z0-9]+/);
+    for (const category in categoryKeywords) {
+        if (categoryKeywords[category as CommandCategory].some(keyword => words.includes(keyword))) {
+            return category as CommandCategory;
+        }
+    }
+    return null;
+}
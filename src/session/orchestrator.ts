@@ -1,7 +1,7 @@
 // /Users/rob/Development/SuperCode/SuperCode/src/session/orchestrator.ts
 import * as fs from 'fs/promises';
 import * as path from 'path';
-import { domainKeywords, intentKeywords } from './detection-patterns';
+import { domainKeywords, intentKeywords, detectCategory, getCommandCategory, type CommandCategory } from './detection-patterns';
 import { CommandParser, ParsedCommand } from '../tool/command-parser';
 import { FlagResolver, ResolvedFlags } from '../tool/flag-resolver';
 import type { IOrchestrator } from './interfaces';
@@ -17,6 +17,7 @@ interface ExecutionContext {
     command: string;
     parsedCommand: ParsedCommand;
     resolvedFlags: ResolvedFlags;
+    category: CommandCategory;
     persona?: Persona;
     userInput: string;
     sessionId?: string;
@@ -118,6 +119,10 @@ export class Orchestrator implements IOrchestrator {
         return bestIntent;
     }
 
+    public detectCategory(userInput: string): CommandCategory | null {
+        return detectCategory(userInput);
+    }
+
 public detectPersona(userInput: string): string | null {
         const domain = this.detectDomain(userInput);
         const intent = this.detectIntent(userInput);
@@ -210,6 +215,7 @@ public detectPersona(userInput: string): string | null {
                 command: parsedCommand.command,
                 parsedCommand,
                 resolvedFlags: flagResult.resolved,
+                category: getCommandCategory(parsedCommand.command),
                 persona,
                 userInput: props.userInput,
                 sessionId: props.sessionId,
@@ -284,6 +290,7 @@ public detectPersona(userInput: string): string | null {
             const commandArgs = {
                 ...parsedCommand,
                 flags: resolvedFlags,
+                category: context.category,
                 persona: context.persona,
                 sessionId: context.sessionId
             };
@@ -316,6 +323,7 @@ public detectPersona(userInput: string): string | null {
             target: parsedCommand.target,
             args: parsedCommand.args,
             flags: resolvedFlags,
+            category: context.category,
             persona: context.persona?.name,
             description: `Would execute ${command} command with the specified parameters`
         };
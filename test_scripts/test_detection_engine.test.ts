@@ -1,5 +1,6 @@
 // /Users/rob/Development/SuperCode/SuperCode/test_scripts/test_detection_engine.ts
 import { Orchestrator } from '../src/session/orchestrator';
+import { getCommandCategory } from '../src/session/detection-patterns';
 import { expect, test, describe } from "bun:test";
 
 describe("Detection Engine", () => {
@@ -58,4 +59,22 @@ describe("Detection Engine", () => {
         const persona = orchestrator.detectPersona(userInput);
         expect(persona).toBe("architect");
     });
+
+    test("should detect 'process' category from build keywords", () => {
+        const userInput = "build and deploy the service";
+        const category = orchestrator.detectCategory(userInput);
+        expect(category).toBe("process");
+    });
+
+    test("should match category keywords as whole words only", () => {
+        expect(orchestrator.detectCategory("download the latest release")).toBe(null);
+    });
+
+    test("should map command names onto the shared category taxonomy", () => {
+        expect(getCommandCategory("analyze")).toBe("analysis");
+        expect(getCommandCategory("refactor")).toBe("modification");
+        expect(getCommandCategory("build")).toBe("process");
+        expect(getCommandCategory("spawn")).toBe("utility");
+        expect(getCommandCategory("unknown-command")).toBe("utility");
+    });
 });
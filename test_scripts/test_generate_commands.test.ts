@@ -0,0 +1,113 @@
+// /Users/rob/Development/SuperCode/SuperCode/test_scripts/test_generate_commands.test.ts
+import * as fs from 'fs/promises';
+import * as os from 'os';
+import * as path from 'path';
+import { toIdentifier, parseCommandName, commandOutputPath, resolveContainedPath, prepareContainedDirectory, moduleImportPath } from '../scripts/pipeline/generate-commands';
+import { expect, test, describe } from "bun:test";
+
+describe("Command Generator identifiers", () => {
+    test("should keep simple and camelCase names as PascalCase", () => {
+        expect(toIdentifier("analyze")).toBe("Analyze");
+        expect(toIdentifier("devSetup")).toBe("DevSetup");
+    });
+
+    test("should convert hyphenated names into valid identifiers", () => {
+        expect(toIdentifier("dev-setup")).toBe("DevSetup");
+        expect(toIdentifier("code_review-v2")).toBe("CodeReviewV2");
+    });
+
+    test("should convert namespaced names into valid identifiers", () => {
+        expect(toIdentifier("user:compress")).toBe("UserCompress");
+        expect(toIdentifier("project:dev-setup")).toBe("ProjectDevSetup");
+    });
+
+    test("should never start an identifier with a digit", () => {
+        expect(toIdentifier("2fa")).toBe("_2fa");
+        expect(toIdentifier(":::")).toBe("Unnamed");
+    });
+
+    test("should resolve collisions with a numeric suffix", () => {
+        const taken = new Set<string>();
+        expect(toIdentifier("dev-setup", taken)).toBe("DevSetup");
+        expect(toIdentifier("dev:setup", taken)).toBe("DevSetup2");
+        expect(toIdentifier("devSetup", taken)).toBe("DevSetup3");
+        expect(taken.size).toBe(3);
+    });
+});
+
+describe("Command Generator namespaces", () => {
+    test("should leave un-namespaced commands at the top level", () => {
+        expect(parseCommandName("analyze")).toEqual({ namespace: [], name: "analyze" });
+        expect(commandOutputPath("analyze")).toBe("analyze.ts");
+    });
+
+    test("should split namespaced commands into nested directories", () => {
+        expect(parseCommandName("user:compress")).toEqual({ namespace: ["user"], name: "compress" });
+        expect(commandOutputPath("user:compress")).toBe(path.join("user", "compress.ts"));
+        expect(commandOutputPath("project:dev-setup")).toBe(path.join("project", "devSetup.ts"));
+    });
+
+    test("should reject empty, dot and separator-containing segments", () => {
+        expect(() => commandOutputPath("user:")).toThrow();
+        expect(() => commandOutputPath("foo/bar")).toThrow();
+        expect(() => commandOutputPath("foo\\bar")).toThrow();
+        expect(() => commandOutputPath("x:.")).toThrow();
+        expect(() => commandOutputPath(":compress")).toThrow();
+    });
+
+    test("should import the cmd helper relative to the written file", () => {
+        const repoRoot = path.resolve("/tmp/supercode");
+        const cmdModule = path.join(repoRoot, "src", "cmd");
+        expect(moduleImportPath(path.join(repoRoot, "src", "commands", "analyze.ts"), cmdModule)).toBe("../cmd");
+        expect(moduleImportPath(path.join(repoRoot, "src", "commands", "user", "compress.ts"), cmdModule)).toBe("../../cmd");
+    });
+});
+
+describe("Command Generator output containment", () => {
+    const outputDir = path.resolve("/tmp/supercode/src/commands");
+
+    test("should resolve regular and namespaced paths inside the output directory", () => {
+        expect(resolveContainedPath(outputDir, "analyze.ts")).toBe(path.join(outputDir, "analyze.ts"));
+        expect(resolveContainedPath(outputDir, commandOutputPath("user:compress")))
+            .toBe(path.join(outputDir, "user", "compress.ts"));
+    });
+
+    test("should reject traversal attempts", () => {
+        expect(() => resolveContainedPath(outputDir, commandOutputPath("../../etc/passwd"))).toThrow();
+        expect(() => resolveContainedPath(outputDir, commandOutputPath("..:..:escape"))).toThrow();
+        expect(() => resolveContainedPath(outputDir, "/etc/passwd.ts")).toThrow();
+    });
+
+    test("should reject traversal that normalizes back inside the output directory", () => {
+        expect(() => resolveContainedPath(outputDir, commandOutputPath("a:..:b"))).toThrow();
+        expect(() => resolveContainedPath(outputDir, "a/../b.ts")).toThrow();
+        expect(() => resolveContainedPath(outputDir, "x\\..\\y.ts")).toThrow();
+    });
+
+    test("should refuse to write through symlinked directories and files", async () => {
+        const root = await fs.mkdtemp(path.join(os.tmpdir(), "supercode-generate-"));
+        try {
+            const commandsDir = path.join(root, "commands");
+            const outsideDir = path.join(root, "outside");
+            await fs.mkdir(commandsDir);
+            await fs.mkdir(outsideDir);
+            await fs.symlink(outsideDir, path.join(commandsDir, "user"));
+
+            const escaping = resolveContainedPath(commandsDir, commandOutputPath("user:compress"));
+            await expect(prepareContainedDirectory(commandsDir, escaping)).rejects.toThrow();
+
+            const outsideFile = path.join(outsideDir, "analyze.ts");
+            await fs.writeFile(outsideFile, "original");
+            await fs.symlink(outsideFile, path.join(commandsDir, "analyze.ts"));
+            const linkedFile = resolveContainedPath(commandsDir, commandOutputPath("analyze"));
+            await expect(prepareContainedDirectory(commandsDir, linkedFile)).rejects.toThrow();
+            expect(await fs.readFile(outsideFile, "utf-8")).toBe("original");
+
+            const nested = resolveContainedPath(commandsDir, commandOutputPath("project:deep:setup"));
+            await prepareContainedDirectory(commandsDir, nested);
+            expect((await fs.stat(path.dirname(nested))).isDirectory()).toBe(true);
+        } finally {
+            await fs.rm(root, { recursive: true, force: true });
+        }
+    });
+});